@@ -4,4 +4,4 @@ go 1.24.2
 
 require github.com/machship/step-essentials v0.0.0-20251002115513-588e1ad886df
 
-require gopkg.in/yaml.v3 v3.0.1 // indirect
+require gopkg.in/yaml.v3 v3.0.1
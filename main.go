@@ -1,19 +1,40 @@
 package main
 
 import (
+	"os"
+
 	"github.com/machship/step-essentials/io"
+	"github.com/machship/test-step/stepinputs"
 )
 
 func main() {
-	inputs := io.GetInputs()
+	if err := stepinputs.Check(os.Args[1:]); err != nil {
+		io.SetOutputs(map[string]any{
+			"error":      err.Error(),
+			"error_code": "INPUT_PARSE_ERROR",
+		})
+		os.Exit(1)
+	}
+
+	outputs, code := run(io.GetInputs())
+	io.SetOutputs(outputs)
+	os.Exit(code)
+}
+
+// run builds the step outputs from inputs and returns the exit code.
+func run(inputs map[string]any) (map[string]any, int) {
+	p := stepinputs.NewParser(inputs)
 
-	name, ok := inputs["name"].(string)
-	if !ok || name == "" {
-		name = "World"
+	name := p.String("name", "World")
+	if errs := p.Errors(); len(errs) > 0 {
+		return map[string]any{
+			"error":                   "invalid step inputs",
+			"error_code":              "INPUT_VALIDATION_ERROR",
+			"input_validation_errors": errs,
+		}, 1
 	}
-	msg := getMessage(name)
 
-	io.SetOutputs(map[string]any{
-		"message": msg,
-	})
+	return map[string]any{
+		"message": getMessage(name),
+	}, 0
 }
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   map[string]any
+		want     map[string]any
+		wantCode int
+	}{
+		{"missing name", map[string]any{}, map[string]any{"message": "HelloWorld"}, 0},
+		{"nil name", map[string]any{"name": nil}, map[string]any{"message": "HelloWorld"}, 0},
+		{"empty name", map[string]any{"name": ""}, map[string]any{"message": "HelloWorld"}, 0},
+		{"string name", map[string]any{"name": "Alice"}, map[string]any{"message": "HelloAlice"}, 0},
+		{"int name", map[string]any{"name": 5}, map[string]any{
+			"error":                   "invalid step inputs",
+			"error_code":              "INPUT_VALIDATION_ERROR",
+			"input_validation_errors": []string{"name: expected string, got int"},
+		}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, code := run(tt.inputs)
+			if code != tt.wantCode {
+				t.Errorf("run() code = %d, want %d", code, tt.wantCode)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("run() outputs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetMessage(t *testing.T) {
+	long := strings.Repeat("a", 10000)
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty name", "", "Hello"},
+		{"plain name", "Alice", "HelloAlice"},
+		{"long name", long, "Hello" + long},
+		{"unicode", "José 世界 👋", "HelloJosé 世界 👋"},
+		{"format verbs", "%s %d %v", "Hello%s %d %v"},
+		{"template syntax", "{{.Name}} ${name}", "Hello{{.Name}} ${name}"},
+		{"quotes and escapes", `"O'Brien"\n`, `Hello"O'Brien"\n`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getMessage(tt.in); got != tt.want {
+				t.Errorf("getMessage(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package stepinputs
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Check reports whether the --inputs or --inputs-file argument in args can be
+// read and parsed as YAML. io.GetInputs prints such errors and returns empty
+// inputs, so steps call Check first to fail instead of running on defaults.
+func Check(args []string) error {
+	fs := flag.NewFlagSet("inputs", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	inputsYAML := fs.String("inputs", "", "")
+	inputsFile := fs.String("inputs-file", "", "")
+	if err := fs.Parse(args); err != nil {
+		// Flag errors are reported by io.GetInputs itself.
+		return nil
+	}
+
+	data := []byte(*inputsYAML)
+	if *inputsFile != "" {
+		b, err := os.ReadFile(*inputsFile)
+		if err != nil {
+			return fmt.Errorf("reading inputs file %s: %w", *inputsFile, err)
+		}
+		data = b
+	}
+
+	inputs := make(map[string]any)
+	if err := yaml.Unmarshal(data, &inputs); err != nil {
+		return fmt.Errorf("parsing inputs YAML: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package stepinputs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.yaml")
+	bad := filepath.Join(dir, "bad.yaml")
+	if err := os.WriteFile(good, []byte("name: Alice\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(bad, []byte("name: [\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{"no inputs", nil, false},
+		{"valid inline", []string{"--inputs", "name: Alice"}, false},
+		{"malformed inline", []string{"--inputs", "name: ["}, true},
+		{"non-map inline", []string{"--inputs", "- a"}, true},
+		{"valid file", []string{"--inputs-file", good}, false},
+		{"malformed file", []string{"--inputs-file", bad}, true},
+		{"missing file", []string{"--inputs-file", filepath.Join(dir, "missing.yaml")}, true},
+		{"file wins over inline", []string{"--inputs", "name: [", "--inputs-file", good}, false},
+		{"unknown flag", []string{"--other"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Check(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("Check(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
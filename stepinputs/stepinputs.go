@@ -0,0 +1,95 @@
+// Package stepinputs reads typed values from step inputs for use by any step.
+package stepinputs
+
+import "fmt"
+
+// Parser reads typed values from step inputs and records type
+// mismatches instead of silently falling back to defaults.
+type Parser struct {
+	inputs map[string]any
+	errs   []string
+}
+
+// NewParser returns a Parser over the given step inputs.
+func NewParser(inputs map[string]any) *Parser {
+	return &Parser{inputs: inputs}
+}
+
+// String returns the string input for key, or def if it is missing or empty.
+// A value of any other type is recorded as an error.
+func (p *Parser) String(key, def string) string {
+	v, ok := p.lookup(key)
+	if !ok {
+		return def
+	}
+	s, ok := v.(string)
+	if !ok {
+		p.mismatch(key, "string", v)
+		return def
+	}
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// Int returns the integer input for key, or def if it is missing.
+// A value of any other type is recorded as an error.
+func (p *Parser) Int(key string, def int) int {
+	v, ok := p.lookup(key)
+	if !ok {
+		return def
+	}
+	i, ok := v.(int)
+	if !ok {
+		p.mismatch(key, "int", v)
+		return def
+	}
+	return i
+}
+
+// Float returns the numeric input for key, or def if it is missing.
+// Integers are accepted; a value of any other type is recorded as an error.
+func (p *Parser) Float(key string, def float64) float64 {
+	v, ok := p.lookup(key)
+	if !ok {
+		return def
+	}
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	}
+	p.mismatch(key, "float", v)
+	return def
+}
+
+// Bool returns the boolean input for key, or def if it is missing.
+// A value of any other type is recorded as an error.
+func (p *Parser) Bool(key string, def bool) bool {
+	v, ok := p.lookup(key)
+	if !ok {
+		return def
+	}
+	b, ok := v.(bool)
+	if !ok {
+		p.mismatch(key, "bool", v)
+		return def
+	}
+	return b
+}
+
+// Errors returns the type mismatches collected so far.
+func (p *Parser) Errors() []string {
+	return p.errs
+}
+
+func (p *Parser) lookup(key string) (any, bool) {
+	v, ok := p.inputs[key]
+	return v, ok && v != nil
+}
+
+func (p *Parser) mismatch(key, want string, got any) {
+	p.errs = append(p.errs, fmt.Sprintf("%s: expected %s, got %T", key, want, got))
+}
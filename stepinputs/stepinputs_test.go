@@ -0,0 +1,139 @@
+package stepinputs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputs  map[string]any
+		want    string
+		wantErr []string
+	}{
+		{"missing key", map[string]any{}, "def", nil},
+		{"nil value", map[string]any{"name": nil}, "def", nil},
+		{"empty string", map[string]any{"name": ""}, "def", nil},
+		{"string value", map[string]any{"name": "Alice"}, "Alice", nil},
+		{"int value", map[string]any{"name": 123}, "def", []string{"name: expected string, got int"}},
+		{"bool value", map[string]any{"name": true}, "def", []string{"name: expected string, got bool"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(tt.inputs)
+			if got := p.String("name", "def"); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if got := p.Errors(); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Errors() = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputs  map[string]any
+		want    int
+		wantErr []string
+	}{
+		{"missing key", map[string]any{}, 7, nil},
+		{"nil value", map[string]any{"n": nil}, 7, nil},
+		{"zero", map[string]any{"n": 0}, 0, nil},
+		{"int value", map[string]any{"n": 42}, 42, nil},
+		{"float value", map[string]any{"n": 4.2}, 7, []string{"n: expected int, got float64"}},
+		{"string value", map[string]any{"n": "42"}, 7, []string{"n: expected int, got string"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(tt.inputs)
+			if got := p.Int("n", 7); got != tt.want {
+				t.Errorf("Int() = %d, want %d", got, tt.want)
+			}
+			if got := p.Errors(); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Errors() = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputs  map[string]any
+		want    float64
+		wantErr []string
+	}{
+		{"missing key", map[string]any{}, 1.5, nil},
+		{"nil value", map[string]any{"f": nil}, 1.5, nil},
+		{"float value", map[string]any{"f": 2.25}, 2.25, nil},
+		{"int value", map[string]any{"f": 3}, 3, nil},
+		{"string value", map[string]any{"f": "2.25"}, 1.5, []string{"f: expected float, got string"}},
+		{"bool value", map[string]any{"f": true}, 1.5, []string{"f: expected float, got bool"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(tt.inputs)
+			if got := p.Float("f", 1.5); got != tt.want {
+				t.Errorf("Float() = %v, want %v", got, tt.want)
+			}
+			if got := p.Errors(); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Errors() = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBool(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputs  map[string]any
+		want    bool
+		wantErr []string
+	}{
+		{"missing key", map[string]any{}, true, nil},
+		{"nil value", map[string]any{"b": nil}, true, nil},
+		{"false", map[string]any{"b": false}, false, nil},
+		{"true", map[string]any{"b": true}, true, nil},
+		{"string value", map[string]any{"b": "false"}, true, []string{"b: expected bool, got string"}},
+		{"int value", map[string]any{"b": 0}, true, []string{"b: expected bool, got int"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(tt.inputs)
+			if got := p.Bool("b", true); got != tt.want {
+				t.Errorf("Bool() = %v, want %v", got, tt.want)
+			}
+			if got := p.Errors(); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Errors() = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs map[string]any
+		want   []string
+	}{
+		{"all valid", map[string]any{"a": "x", "b": "y", "c": "z"}, nil},
+		{"mismatches in order", map[string]any{"a": 1, "b": "y", "c": 2.5}, []string{
+			"a: expected string, got int",
+			"c: expected string, got float64",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(tt.inputs)
+			for _, key := range []string{"a", "b", "c"} {
+				p.String(key, "")
+			}
+			if got := p.Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Errors() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}